@@ -0,0 +1,139 @@
+package moacrpc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// BatchElem is a single call within a BatchCall: set Method and Params
+// before calling BatchCall, then read Result/Error back out of it once
+// BatchCall returns.
+type BatchElem struct {
+	Method string
+	Params []interface{}
+	Result interface{}
+	Error  error
+}
+
+// batchTransport is implemented by Transports that can send a JSON-RPC
+// batch request in a single round trip; HTTPTransport does. Transports
+// without a native batch mode fall back to concurrent individual calls in
+// MoacRPC.BatchCall.
+type batchTransport interface {
+	BatchCall(ctx context.Context, calls []BatchElem) error
+}
+
+// BatchCall sends calls as a single JSON-RPC batch request when the
+// transport supports it, and scatters each response (or error) back into
+// the matching BatchElem. This turns what would be N sequential round
+// trips - e.g. a block scanner's N calls to MoacGetBlockByNumber - into one.
+func (rpc *MoacRPC) BatchCall(ctx context.Context, calls []BatchElem) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	if batcher, ok := rpc.transport.(batchTransport); ok {
+		return batcher.BatchCall(ctx, calls)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(calls))
+	for i := range calls {
+		go func(call *BatchElem) {
+			defer wg.Done()
+
+			result, err := rpc.transport.Call(ctx, call.Method, call.Params...)
+			if err != nil {
+				call.Error = err
+				return
+			}
+			if call.Result != nil {
+				call.Error = json.Unmarshal(result, call.Result)
+			}
+		}(&calls[i])
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// BatchCaller coalesces Call invocations issued concurrently within a small
+// time window into a single BatchCall, so callers that can't restructure
+// their own code into an explicit batch (e.g. a pool of workers each
+// fetching one block) still amortize round trips.
+type BatchCaller struct {
+	rpc    *MoacRPC
+	window time.Duration
+
+	mu      sync.Mutex
+	pending []*batchCallerRequest
+	timer   *time.Timer
+}
+
+type batchCallerRequest struct {
+	method string
+	params []interface{}
+	result chan batchCallerResult
+}
+
+type batchCallerResult struct {
+	data json.RawMessage
+	err  error
+}
+
+// NewBatchCaller returns a BatchCaller over rpc that flushes its queued
+// calls window after the first call joins the batch.
+func NewBatchCaller(rpc *MoacRPC, window time.Duration) *BatchCaller {
+	return &BatchCaller{rpc: rpc, window: window}
+}
+
+// Call enqueues a method call and blocks until the batch it was coalesced
+// into is flushed and its result is available, or ctx is done.
+func (b *BatchCaller) Call(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error) {
+	req := &batchCallerRequest{method: method, params: params, result: make(chan batchCallerResult, 1)}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, req)
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case res := <-req.result:
+		return res.data, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *BatchCaller) flush() {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.timer = nil
+	b.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	calls := make([]BatchElem, len(pending))
+	for i, req := range pending {
+		calls[i] = BatchElem{Method: req.method, Params: req.params, Result: new(json.RawMessage)}
+	}
+
+	err := b.rpc.BatchCall(context.Background(), calls)
+	for i, req := range pending {
+		switch {
+		case err != nil:
+			req.result <- batchCallerResult{err: err}
+		case calls[i].Error != nil:
+			req.result <- batchCallerResult{err: calls[i].Error}
+		default:
+			req.result <- batchCallerResult{data: *calls[i].Result.(*json.RawMessage)}
+		}
+	}
+}
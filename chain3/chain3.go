@@ -0,0 +1,177 @@
+// Package chain3 provides a high-level facade over moacrpc.MoacRPC, adding
+// the unit conversion and ASCII/hex helpers that callers otherwise have to
+// hand-roll around every raw RPC call, mirroring caivega/chain3go's Chain3.
+package chain3
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	moacrpc "github.com/ChangMM/moac-golang-chain3-api"
+	"golang.org/x/crypto/sha3"
+)
+
+// Chain3 wraps a MoacRPC client with unit conversion and encoding helpers.
+type Chain3 struct {
+	Mc *moacrpc.MoacRPC
+}
+
+// New wraps rpc in a Chain3 facade.
+func New(rpc *moacrpc.MoacRPC) *Chain3 {
+	return &Chain3{Mc: rpc}
+}
+
+// FromSha converts value sha (wei) into the given unit (e.g. "ether",
+// "gwei"), returning its decimal string representation.
+func (c3 *Chain3) FromSha(value *big.Int, unit string) (string, error) {
+	return FromSha(value, unit)
+}
+
+// FromSha converts value sha (wei) into the given unit (e.g. "ether",
+// "gwei"), returning its decimal string representation.
+func FromSha(value *big.Int, unit string) (string, error) {
+	exp, err := exponentFor(strings.ToLower(unit))
+	if err != nil {
+		return "", err
+	}
+
+	result := new(big.Rat).SetInt(value)
+	if exp > 0 {
+		result.Quo(result, new(big.Rat).SetInt(pow10(exp)))
+	}
+
+	return result.FloatString(int(exp)), nil
+}
+
+// ToSha converts value, expressed in the given unit (e.g. "ether", "gwei"),
+// into sha (wei).
+func (c3 *Chain3) ToSha(value string, unit string) (*big.Int, error) {
+	return ToSha(value, unit)
+}
+
+// ToSha converts value, expressed in the given unit (e.g. "ether", "gwei"),
+// into sha (wei).
+func ToSha(value string, unit string) (*big.Int, error) {
+	exp, err := exponentFor(strings.ToLower(unit))
+	if err != nil {
+		return nil, err
+	}
+
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("chain3: invalid decimal value %q", value)
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(pow10(exp)))
+
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("chain3: %s %s is not a whole number of sha", value, unit)
+	}
+
+	return amount.Num(), nil
+}
+
+func pow10(exp uint) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(uint64(exp)), nil)
+}
+
+// FromASCII converts an ASCII string into a 0x-prefixed hex string, right
+// padded with zero bytes up to padding total bytes (padding <= 0 disables padding).
+func FromASCII(s string, padding int) string {
+	data := []byte(s)
+	if padding > len(data) {
+		data = append(data, make([]byte, padding-len(data))...)
+	}
+
+	return "0x" + hex.EncodeToString(data)
+}
+
+// ToASCII converts a 0x-prefixed hex string back into an ASCII string,
+// trimming trailing zero-byte padding.
+func ToASCII(hexStr string) string {
+	data, err := hex.DecodeString(strings.TrimPrefix(hexStr, "0x"))
+	if err != nil {
+		return ""
+	}
+
+	return string(bytes.TrimRight(data, "\x00"))
+}
+
+// FromDecimal converts a 0x-prefixed hex string into its decimal string representation.
+func FromDecimal(hexStr string) (string, error) {
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(hexStr, "0x"), 16)
+	if !ok {
+		return "", fmt.Errorf("chain3: invalid hex value %q", hexStr)
+	}
+
+	return value.String(), nil
+}
+
+// ToDecimal converts a decimal string into a 0x-prefixed hex string.
+func ToDecimal(decimal string) (string, error) {
+	value, ok := new(big.Int).SetString(decimal, 10)
+	if !ok {
+		return "", fmt.Errorf("chain3: invalid decimal value %q", decimal)
+	}
+
+	return "0x" + value.Text(16), nil
+}
+
+// Sha3 returns the Keccak-256 (not the standardized SHA3-256) digest of
+// data, computed locally rather than round-tripping through chain3_sha3.
+func Sha3(data []byte) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+
+	return "0x" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// IsAddress reports whether s is a well-formed 20-byte hex address. If s
+// uses mixed case, it must satisfy the EIP-55 checksum; an all-lowercase or
+// all-uppercase address is accepted without a checksum check.
+func IsAddress(s string) bool {
+	if strings.HasPrefix(strings.ToLower(s), "0x") {
+		s = s[2:]
+	}
+	if len(s) != 40 {
+		return false
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return false
+	}
+
+	if s == strings.ToLower(s) || s == strings.ToUpper(s) {
+		return true
+	}
+
+	return s == checksumAddress(strings.ToLower(s))
+}
+
+// checksumAddress applies the EIP-55 mixed-case checksum to a lowercase,
+// 0x-stripped hex address.
+func checksumAddress(lowerHex string) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lowerHex))
+	digest := hash.Sum(nil)
+
+	out := []byte(lowerHex)
+	for i, c := range out {
+		if c < 'a' || c > 'f' {
+			continue
+		}
+		// i-th nibble's hash bit selects upper vs lower case.
+		nibble := digest[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		} else {
+			nibble &= 0x0f
+		}
+		if nibble >= 8 {
+			out[i] = c - ('a' - 'A')
+		}
+	}
+
+	return string(out)
+}
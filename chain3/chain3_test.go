@@ -0,0 +1,89 @@
+package chain3
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFromSha(t *testing.T) {
+	cases := []struct {
+		value *big.Int
+		unit  string
+		want  string
+	}{
+		{big.NewInt(1000000000000000000), "ether", "1.000000000000000000"},
+		{big.NewInt(1500000000), "gwei", "1.500000000"},
+		{big.NewInt(42), "wei", "42"},
+	}
+
+	for _, c := range cases {
+		got, err := FromSha(c.value, c.unit)
+		if err != nil {
+			t.Fatalf("FromSha(%s, %q): unexpected error: %v", c.value, c.unit, err)
+		}
+		if got != c.want {
+			t.Errorf("FromSha(%s, %q) = %q, want %q", c.value, c.unit, got, c.want)
+		}
+	}
+
+	if _, err := FromSha(big.NewInt(1), "bogus"); err == nil {
+		t.Error("FromSha with unknown unit: expected error, got nil")
+	}
+}
+
+func TestToSha(t *testing.T) {
+	cases := []struct {
+		value string
+		unit  string
+		want  *big.Int
+	}{
+		{"1", "ether", big.NewInt(1000000000000000000)},
+		{"1.5", "gwei", big.NewInt(1500000000)},
+		{"42", "wei", big.NewInt(42)},
+	}
+
+	for _, c := range cases {
+		got, err := ToSha(c.value, c.unit)
+		if err != nil {
+			t.Fatalf("ToSha(%q, %q): unexpected error: %v", c.value, c.unit, err)
+		}
+		if got.Cmp(c.want) != 0 {
+			t.Errorf("ToSha(%q, %q) = %s, want %s", c.value, c.unit, got, c.want)
+		}
+	}
+
+	if _, err := ToSha("0.1", "wei"); err == nil {
+		t.Error("ToSha with a fractional sha amount: expected error, got nil")
+	}
+	if _, err := ToSha("1", "bogus"); err == nil {
+		t.Error("ToSha with unknown unit: expected error, got nil")
+	}
+}
+
+func TestIsAddress(t *testing.T) {
+	const (
+		checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+		lower       = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+		upper       = "0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"
+		badChecksum = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAEd"
+	)
+
+	if !IsAddress(checksummed) {
+		t.Errorf("IsAddress(%q) = false, want true", checksummed)
+	}
+	if !IsAddress(lower) {
+		t.Errorf("IsAddress(%q) = false, want true", lower)
+	}
+	if !IsAddress(upper) {
+		t.Errorf("IsAddress(%q) = false, want true", upper)
+	}
+	if IsAddress(badChecksum) {
+		t.Errorf("IsAddress(%q) = true, want false (bad checksum)", badChecksum)
+	}
+	if IsAddress("0x1234") {
+		t.Error("IsAddress with wrong length: expected false")
+	}
+	if IsAddress("0xzz0000000000000000000000000000000000000z") {
+		t.Error("IsAddress with non-hex characters: expected false")
+	}
+}
@@ -0,0 +1,35 @@
+package chain3
+
+import "fmt"
+
+// unitExponent maps the unit names accepted by FromSha/ToSha to the power of
+// ten of sha (wei) they represent, mirroring the unit table used by web3.js
+// and caivega/chain3go.
+var unitExponent = map[string]uint{
+	"sha":         0,
+	"wei":         0,
+	"kwei":        3,
+	"babbage":     3,
+	"mwei":        6,
+	"gwei":        9,
+	"shannon":     9,
+	"microether":  12,
+	"micromoac":   12,
+	"milliether":  15,
+	"millimoac":   15,
+	"ether":       18,
+	"moac":        18,
+	"kether":      21,
+	"grand":       21,
+	"mether":      24,
+	"gether":      27,
+	"tether":      30,
+}
+
+func exponentFor(unit string) (uint, error) {
+	exp, ok := unitExponent[unit]
+	if !ok {
+		return 0, fmt.Errorf("chain3: unknown unit %q", unit)
+	}
+	return exp, nil
+}
@@ -0,0 +1,176 @@
+package moacrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+// RetryPolicy controls how FailoverClient retries a transient failure
+// before giving up or moving on to the next endpoint.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries across all endpoints,
+	// including the first one. Values <= 0 are treated as 1 (no retry).
+	MaxAttempts int
+	// BaseBackoff is the backoff before the first retry; it doubles on each
+	// subsequent attempt up to MaxBackoff. Each actual delay is jittered to
+	// between half and all of that backoff. Defaults to 200ms.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. Defaults to 10s.
+	MaxBackoff time.Duration
+	// RetryableErrors lists JSON-RPC error codes (e.g. -32005 for
+	// rate-limited) that should be treated as transient alongside 5xx
+	// responses, connection failures and 429s.
+	RetryableErrors []int
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 1
+	}
+	if p.BaseBackoff <= 0 {
+		p.BaseBackoff = 200 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+	return p
+}
+
+func (p RetryPolicy) backoffFor(attempt int) time.Duration {
+	backoff := p.BaseBackoff * time.Duration(uint(1)<<uint(attempt))
+	if backoff <= 0 || backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+	return backoff/2 + jitter/2
+}
+
+func (p RetryPolicy) wait(ctx context.Context, attempt int) error {
+	select {
+	case <-time.After(p.backoffFor(attempt)):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isRetryable classifies an HTTP response as transient (5xx, 429, or a
+// RetryableErrors JSON-RPC code) vs. permanent (everything else, e.g. a
+// revert or invalid params).
+func (p RetryPolicy) isRetryable(status int, body []byte) bool {
+	if status >= 500 || status == http.StatusTooManyRequests {
+		return true
+	}
+	if len(p.RetryableErrors) == 0 {
+		return false
+	}
+
+	var parsed struct {
+		Error *MoacError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.Error == nil {
+		return false
+	}
+
+	for _, code := range p.RetryableErrors {
+		if parsed.Error.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FailoverClient is an httpClient that spreads requests across multiple
+// endpoints for HA: it starts at a different endpoint on each call (a
+// simple weighted round-robin over a uniform weight), retries transient
+// failures (5xx, connection errors, rate-limit codes) with exponential
+// backoff and jitter, and short-circuits on permanent ones.
+type FailoverClient struct {
+	endpoints []string
+	client    httpClient
+	policy    RetryPolicy
+
+	cursor uint32
+}
+
+// NewFailoverClient returns a FailoverClient trying endpoints in order (with
+// a rotating starting point) via client, retrying according to policy. If
+// client is nil, http.DefaultClient is used.
+func NewFailoverClient(endpoints []string, client httpClient, policy RetryPolicy) *FailoverClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &FailoverClient{endpoints: endpoints, client: client, policy: policy}
+}
+
+// Do implements httpClient.
+func (f *FailoverClient) Do(req *http.Request) (*http.Response, error) {
+	if len(f.endpoints) == 0 {
+		return nil, errors.New("moacrpc: FailoverClient has no endpoints")
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	policy := f.policy.withDefaults()
+	start := int(atomic.AddUint32(&f.cursor, 1) - 1)
+
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		endpoint := f.endpoints[(start+attempt)%len(f.endpoints)]
+
+		cloned := req.Clone(req.Context())
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		cloned.URL = u
+		cloned.Host = u.Host
+		if body != nil {
+			cloned.Body = ioutil.NopCloser(bytes.NewReader(body))
+			cloned.ContentLength = int64(len(body))
+		}
+
+		resp, err := f.client.Do(cloned)
+		if err != nil {
+			lastErr = err
+		} else {
+			data, readErr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return nil, readErr
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+			if !policy.isRetryable(resp.StatusCode, data) {
+				return resp, nil
+			}
+			lastErr = fmt.Errorf("moacrpc: transient response from %s (status %d)", endpoint, resp.StatusCode)
+		}
+
+		if attempt < policy.MaxAttempts-1 {
+			if waitErr := policy.wait(req.Context(), attempt); waitErr != nil {
+				return nil, waitErr
+			}
+		}
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,69 @@
+package moacrpc
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := RetryPolicy{RetryableErrors: []int{-32005}}.withDefaults()
+
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{"server error", http.StatusInternalServerError, `{}`, true},
+		{"rate limited status", http.StatusTooManyRequests, `{}`, true},
+		{"retryable rpc code", http.StatusOK, `{"error":{"code":-32005,"message":"rate limited"}}`, true},
+		{"non-retryable rpc code", http.StatusOK, `{"error":{"code":-32000,"message":"reverted"}}`, false},
+		{"success", http.StatusOK, `{"result":"0x1"}`, false},
+		{"malformed body", http.StatusOK, `not json`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := policy.isRetryable(c.status, []byte(c.body)); got != c.want {
+				t.Errorf("isRetryable(%d, %q) = %v, want %v", c.status, c.body, got, c.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyIsRetryableWithoutRetryableErrors(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+
+	if policy.isRetryable(http.StatusOK, []byte(`{"error":{"code":-32005}}`)) {
+		t.Error("isRetryable with no RetryableErrors configured: got true, want false")
+	}
+	if !policy.isRetryable(http.StatusBadGateway, []byte(`{}`)) {
+		t.Error("isRetryable(502, ...): got false, want true")
+	}
+}
+
+func TestRetryPolicyBackoffFor(t *testing.T) {
+	policy := RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}.withDefaults()
+
+	for attempt := 0; attempt < 10; attempt++ {
+		backoff := policy.backoffFor(attempt)
+		if backoff < 0 || backoff > policy.MaxBackoff {
+			t.Errorf("backoffFor(%d) = %s, want a value in [0, %s]", attempt, backoff, policy.MaxBackoff)
+		}
+	}
+}
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+
+	if policy.MaxAttempts != 1 {
+		t.Errorf("MaxAttempts = %d, want 1", policy.MaxAttempts)
+	}
+	if policy.BaseBackoff != 200*time.Millisecond {
+		t.Errorf("BaseBackoff = %s, want 200ms", policy.BaseBackoff)
+	}
+	if policy.MaxBackoff != 10*time.Second {
+		t.Errorf("MaxBackoff = %s, want 10s", policy.MaxBackoff)
+	}
+}
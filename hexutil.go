@@ -0,0 +1,30 @@
+package moacrpc
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// keccak256 returns the Keccak-256 (not the standardized SHA3-256) digest of data.
+func keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// hexToBytes decodes an optionally 0x-prefixed hex string, returning nil
+// for an empty string instead of an error.
+func hexToBytes(s string) []byte {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return nil
+	}
+
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil
+	}
+	return data
+}
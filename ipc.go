@@ -0,0 +1,45 @@
+package moacrpc
+
+import (
+	"bufio"
+	"net"
+)
+
+// ipcConn adapts a unix domain socket connection to the wireConn interface
+// expected by pubsubTransport, framing messages as newline-delimited JSON
+// the way a local moac node's IPC endpoint does.
+type ipcConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func (c *ipcConn) ReadMessage() ([]byte, error) {
+	return c.reader.ReadBytes('\n')
+}
+
+func (c *ipcConn) WriteMessage(data []byte) error {
+	_, err := c.conn.Write(append(data, '\n'))
+	return err
+}
+
+func (c *ipcConn) Close() error {
+	return c.conn.Close()
+}
+
+// IPCTransport is a Transport backed by a unix domain socket connection to
+// a local moac node, supporting both request/response calls and
+// mc_subscribe pub-sub notifications.
+type IPCTransport struct {
+	*pubsubTransport
+}
+
+// NewIPCTransport dials the unix domain socket at path and returns an
+// IPCTransport ready to Call and Subscribe.
+func NewIPCTransport(path string) (*IPCTransport, error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IPCTransport{pubsubTransport: newPubsubTransport(&ipcConn{conn: conn, reader: bufio.NewReader(conn)})}, nil
+}
@@ -0,0 +1,110 @@
+package moacrpc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedKeyJSON mirrors the "crypto" section of a MOAC/Ethereum v3
+// keystore file; NewKeystoreSigner only needs that section.
+type encryptedKeyJSON struct {
+	Crypto cryptoJSON `json:"crypto"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// decryptKeystoreJSON decrypts a MOAC/Ethereum v3 keystore JSON file
+// (cipher aes-128-ctr, kdf scrypt or pbkdf2) with passphrase and returns
+// the raw 32-byte secp256k1 private key.
+func decryptKeystoreJSON(data []byte, passphrase string) ([]byte, error) {
+	var keyJSON encryptedKeyJSON
+	if err := json.Unmarshal(data, &keyJSON); err != nil {
+		return nil, err
+	}
+	crypto := keyJSON.Crypto
+
+	if crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("moacrpc: unsupported keystore cipher %q", crypto.Cipher)
+	}
+
+	derivedKey, err := deriveKeystoreKey(crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := keccak256(append(append([]byte{}, derivedKey[16:32]...), ciphertext...))
+	expectedMAC, err := hex.DecodeString(crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(mac, expectedMAC) != 1 {
+		return nil, errors.New("moacrpc: could not decrypt key: incorrect passphrase")
+	}
+
+	iv, err := hex.DecodeString(crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(plaintext, ciphertext)
+
+	return plaintext, nil
+}
+
+func deriveKeystoreKey(c cryptoJSON, passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(kdfParamString(c.KDFParams, "salt"))
+	if err != nil {
+		return nil, err
+	}
+	dkLen := kdfParamInt(c.KDFParams, "dklen")
+
+	switch c.KDF {
+	case "scrypt":
+		return scrypt.Key([]byte(passphrase), salt, kdfParamInt(c.KDFParams, "n"), kdfParamInt(c.KDFParams, "r"), kdfParamInt(c.KDFParams, "p"), dkLen)
+	case "pbkdf2":
+		return pbkdf2.Key([]byte(passphrase), salt, kdfParamInt(c.KDFParams, "c"), dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("moacrpc: unsupported keystore kdf %q", c.KDF)
+	}
+}
+
+func kdfParamString(params map[string]interface{}, key string) string {
+	s, _ := params[key].(string)
+	return s
+}
+
+func kdfParamInt(params map[string]interface{}, key string) int {
+	f, _ := params[key].(float64)
+	return int(f)
+}
@@ -0,0 +1,78 @@
+package moacrpc
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	secp256k1ecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+// KeystoreSigner signs transactions locally with a private key loaded from
+// a MOAC/Ethereum-compatible encrypted JSON keyfile, using EIP-155 replay
+// protection against chainID. chainID may be left nil at construction and
+// filled in later via SetChainID (SendTransaction does this automatically
+// when a ChainIDProvider is configured via WithTxModifiers).
+type KeystoreSigner struct {
+	key     *btcec.PrivateKey
+	chainID *big.Int
+}
+
+// NewKeystoreSigner decrypts the JSON keyfile at path with passphrase and
+// returns a Signer that signs for chainID.
+func NewKeystoreSigner(path, passphrase string, chainID *big.Int) (*KeystoreSigner, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := decryptKeystoreJSON(data, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _ := btcec.PrivKeyFromBytes(raw)
+	return &KeystoreSigner{key: key, chainID: chainID}, nil
+}
+
+// SetChainID overrides the chain id used for EIP-155 signing. It implements
+// the chainIDSetter hook SendTransaction calls when a ChainIDProvider is
+// configured via WithTxModifiers.
+func (s *KeystoreSigner) SetChainID(chainID *big.Int) {
+	s.chainID = chainID
+}
+
+// Sign implements Signer.
+func (s *KeystoreSigner) Sign(ctx context.Context, tx *T) ([]byte, error) {
+	if s.chainID == nil {
+		return nil, errors.New("moacrpc: KeystoreSigner: chain id not set")
+	}
+
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	gasPrice := tx.GasPrice
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+	to := hexToBytes(tx.To)
+	data := hexToBytes(tx.Data)
+
+	unsigned, err := rlpEncode(uint64(tx.Nonce), gasPrice, uint64(tx.Gas), to, value, data, s.chainID, big.NewInt(0), big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+
+	sig := secp256k1ecdsa.SignCompact(s.key, keccak256(unsigned), false)
+	recoveryID := big.NewInt(int64(sig[0] - 27))
+	r := new(big.Int).SetBytes(sig[1:33])
+	sigS := new(big.Int).SetBytes(sig[33:65])
+
+	v := new(big.Int).Add(new(big.Int).Mul(s.chainID, big.NewInt(2)), big.NewInt(35))
+	v.Add(v, recoveryID)
+
+	return rlpEncode(uint64(tx.Nonce), gasPrice, uint64(tx.Gas), to, value, data, v, r, sigS)
+}
@@ -2,12 +2,11 @@ package moacrpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"math/big"
-	"net/http"
 	"os"
 )
 
@@ -37,18 +36,24 @@ type ethRequest struct {
 
 // MoacRPC - Moacereum rpc client
 type MoacRPC struct {
-	url    string
-	client httpClient
-	log    logger
-	Debug  bool
-}
-
-// New create new rpc client with given url
+	url         string
+	transport   Transport
+	log         logger
+	Debug       bool
+	txModifiers []TxModifier
+	failover    *FailoverClient
+	retryPolicy RetryPolicy
+}
+
+// New create new rpc client with given url. The scheme of url picks the
+// Transport: ws:// and wss:// dial a WebSocketTransport, http:// and https://
+// use the default HTTPTransport, and anything else is treated as a unix
+// domain socket path for IPCTransport. Use WithTransport to override this.
 func New(url string, options ...func(rpc *MoacRPC)) *MoacRPC {
 	rpc := &MoacRPC{
-		url:    url,
-		client: http.DefaultClient,
-		log:    log.New(os.Stderr, "", log.LstdFlags),
+		url:       url,
+		transport: defaultTransport(url),
+		log:       log.New(os.Stderr, "", log.LstdFlags),
 	}
 	for _, option := range options {
 		option(rpc)
@@ -57,13 +62,22 @@ func New(url string, options ...func(rpc *MoacRPC)) *MoacRPC {
 	return rpc
 }
 
+// Close releases the resources (sockets, goroutines) held by the client's transport.
+func (rpc *MoacRPC) Close() error {
+	return rpc.transport.Close()
+}
+
 // NewMoacRPC create new rpc client with given url
 func NewMoacRPC(url string, options ...func(rpc *MoacRPC)) *MoacRPC {
 	return New(url, options...)
 }
 
 func (rpc *MoacRPC) call(method string, target interface{}, params ...interface{}) error {
-	result, err := rpc.Call(method, params...)
+	return rpc.callContext(context.Background(), method, target, params...)
+}
+
+func (rpc *MoacRPC) callContext(ctx context.Context, method string, target interface{}, params ...interface{}) error {
+	result, err := rpc.CallContext(ctx, method, params...)
 	if err != nil {
 		return err
 	}
@@ -77,46 +91,18 @@ func (rpc *MoacRPC) call(method string, target interface{}, params ...interface{
 
 // Call returns raw response of method call
 func (rpc *MoacRPC) Call(method string, params ...interface{}) (json.RawMessage, error) {
-	request := ethRequest{
-		ID:      1,
-		JSONRPC: "2.0",
-		Method:  method,
-		Params:  params,
-	}
-
-	body, err := json.Marshal(request)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := rpc.client.Post(rpc.url, "application/json", bytes.NewBuffer(body))
-	if response != nil {
-		defer response.Body.Close()
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return rpc.CallContext(context.Background(), method, params...)
+}
 
+// CallContext returns raw response of method call, aborting early if ctx is cancelled
+// before the round trip to the node completes.
+func (rpc *MoacRPC) CallContext(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error) {
+	result, err := rpc.transport.Call(ctx, method, params...)
 	if rpc.Debug {
-		rpc.log.Println(fmt.Sprintf("%s\nRequest: %s\nResponse: %s\n", method, body, data))
+		rpc.log.Println(fmt.Sprintf("%s\nParams: %v\nResult: %s\nError: %v\n", method, params, result, err))
 	}
 
-	resp := new(ethResponse)
-	if err := json.Unmarshal(data, resp); err != nil {
-		return nil, err
-	}
-
-	if resp.Error != nil {
-		return nil, *resp.Error
-	}
-
-	return resp.Result, nil
-
+	return result, err
 }
 
 // RawCall returns raw response of method call (Deprecated)
@@ -126,40 +112,65 @@ func (rpc *MoacRPC) RawCall(method string, params ...interface{}) (json.RawMessa
 
 // Chain3ClientVersion returns the current client version.
 func (rpc *MoacRPC) Chain3ClientVersion() (string, error) {
+	return rpc.Chain3ClientVersionContext(context.Background())
+}
+
+// Chain3ClientVersionContext is the context-aware variant of Chain3ClientVersion.
+func (rpc *MoacRPC) Chain3ClientVersionContext(ctx context.Context) (string, error) {
 	var clientVersion string
 
-	err := rpc.call("chain3_clientVersion", &clientVersion)
+	err := rpc.callContext(ctx, "chain3_clientVersion", &clientVersion)
 	return clientVersion, err
 }
 
 // Chain3Sha3 returns Keccak-256 (not the standardized SHA3-256) of the given data.
 func (rpc *MoacRPC) Chain3Sha3(data []byte) (string, error) {
+	return rpc.Chain3Sha3Context(context.Background(), data)
+}
+
+// Chain3Sha3Context is the context-aware variant of Chain3Sha3.
+func (rpc *MoacRPC) Chain3Sha3Context(ctx context.Context, data []byte) (string, error) {
 	var hash string
 
-	err := rpc.call("chain3_sha3", &hash, fmt.Sprintf("0x%x", data))
+	err := rpc.callContext(ctx, "chain3_sha3", &hash, fmt.Sprintf("0x%x", data))
 	return hash, err
 }
 
 // NetVersion returns the current network protocol version.
 func (rpc *MoacRPC) NetVersion() (string, error) {
+	return rpc.NetVersionContext(context.Background())
+}
+
+// NetVersionContext is the context-aware variant of NetVersion.
+func (rpc *MoacRPC) NetVersionContext(ctx context.Context) (string, error) {
 	var version string
 
-	err := rpc.call("net_version", &version)
+	err := rpc.callContext(ctx, "net_version", &version)
 	return version, err
 }
 
 // NetListening returns true if client is actively listening for network connections.
 func (rpc *MoacRPC) NetListening() (bool, error) {
+	return rpc.NetListeningContext(context.Background())
+}
+
+// NetListeningContext is the context-aware variant of NetListening.
+func (rpc *MoacRPC) NetListeningContext(ctx context.Context) (bool, error) {
 	var listening bool
 
-	err := rpc.call("net_listening", &listening)
+	err := rpc.callContext(ctx, "net_listening", &listening)
 	return listening, err
 }
 
 // NetPeerCount returns number of peers currently connected to the client.
 func (rpc *MoacRPC) NetPeerCount() (int, error) {
+	return rpc.NetPeerCountContext(context.Background())
+}
+
+// NetPeerCountContext is the context-aware variant of NetPeerCount.
+func (rpc *MoacRPC) NetPeerCountContext(ctx context.Context) (int, error) {
 	var response string
-	if err := rpc.call("net_peerCount", &response); err != nil {
+	if err := rpc.callContext(ctx, "net_peerCount", &response); err != nil {
 		return 0, err
 	}
 
@@ -168,15 +179,25 @@ func (rpc *MoacRPC) NetPeerCount() (int, error) {
 
 // MoacProtocolVersion returns the current moac protocol version.
 func (rpc *MoacRPC) MoacProtocolVersion() (string, error) {
+	return rpc.MoacProtocolVersionContext(context.Background())
+}
+
+// MoacProtocolVersionContext is the context-aware variant of MoacProtocolVersion.
+func (rpc *MoacRPC) MoacProtocolVersionContext(ctx context.Context) (string, error) {
 	var protocolVersion string
 
-	err := rpc.call("mc_protocolVersion", &protocolVersion)
+	err := rpc.callContext(ctx, "mc_protocolVersion", &protocolVersion)
 	return protocolVersion, err
 }
 
 // MoacSyncing returns an object with data about the sync status or false.
 func (rpc *MoacRPC) MoacSyncing() (*Syncing, error) {
-	result, err := rpc.RawCall("mc_syncing")
+	return rpc.MoacSyncingContext(context.Background())
+}
+
+// MoacSyncingContext is the context-aware variant of MoacSyncing.
+func (rpc *MoacRPC) MoacSyncingContext(ctx context.Context) (*Syncing, error) {
+	result, err := rpc.CallContext(ctx, "mc_syncing")
 	if err != nil {
 		return nil, err
 	}
@@ -190,25 +211,40 @@ func (rpc *MoacRPC) MoacSyncing() (*Syncing, error) {
 
 // MoacCoinbase returns the client coinbase address
 func (rpc *MoacRPC) MoacCoinbase() (string, error) {
+	return rpc.MoacCoinbaseContext(context.Background())
+}
+
+// MoacCoinbaseContext is the context-aware variant of MoacCoinbase.
+func (rpc *MoacRPC) MoacCoinbaseContext(ctx context.Context) (string, error) {
 	var address string
 
-	err := rpc.call("mc_coinbase", &address)
+	err := rpc.callContext(ctx, "mc_coinbase", &address)
 	return address, err
 }
 
 // MoacMining returns true if client is actively mining new blocks.
 func (rpc *MoacRPC) MoacMining() (bool, error) {
+	return rpc.MoacMiningContext(context.Background())
+}
+
+// MoacMiningContext is the context-aware variant of MoacMining.
+func (rpc *MoacRPC) MoacMiningContext(ctx context.Context) (bool, error) {
 	var mining bool
 
-	err := rpc.call("mc_mining", &mining)
+	err := rpc.callContext(ctx, "mc_mining", &mining)
 	return mining, err
 }
 
 // MoacHashrate returns the number of hashes per second that the node is mining with.
 func (rpc *MoacRPC) MoacHashrate() (int, error) {
+	return rpc.MoacHashrateContext(context.Background())
+}
+
+// MoacHashrateContext is the context-aware variant of MoacHashrate.
+func (rpc *MoacRPC) MoacHashrateContext(ctx context.Context) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_hashrate", &response); err != nil {
+	if err := rpc.callContext(ctx, "mc_hashrate", &response); err != nil {
 		return 0, err
 	}
 
@@ -217,8 +253,13 @@ func (rpc *MoacRPC) MoacHashrate() (int, error) {
 
 // MoacGasPrice returns the current price per gas in wei.
 func (rpc *MoacRPC) MoacGasPrice() (big.Int, error) {
+	return rpc.MoacGasPriceContext(context.Background())
+}
+
+// MoacGasPriceContext is the context-aware variant of MoacGasPrice.
+func (rpc *MoacRPC) MoacGasPriceContext(ctx context.Context) (big.Int, error) {
 	var response string
-	if err := rpc.call("mc_gasPrice", &response); err != nil {
+	if err := rpc.callContext(ctx, "mc_gasPrice", &response); err != nil {
 		return big.Int{}, err
 	}
 
@@ -227,16 +268,26 @@ func (rpc *MoacRPC) MoacGasPrice() (big.Int, error) {
 
 // MoacAccounts returns a list of addresses owned by client.
 func (rpc *MoacRPC) MoacAccounts() ([]string, error) {
+	return rpc.MoacAccountsContext(context.Background())
+}
+
+// MoacAccountsContext is the context-aware variant of MoacAccounts.
+func (rpc *MoacRPC) MoacAccountsContext(ctx context.Context) ([]string, error) {
 	accounts := []string{}
 
-	err := rpc.call("mc_accounts", &accounts)
+	err := rpc.callContext(ctx, "mc_accounts", &accounts)
 	return accounts, err
 }
 
 // MoacBlockNumber returns the number of most recent block.
 func (rpc *MoacRPC) MoacBlockNumber() (int, error) {
+	return rpc.MoacBlockNumberContext(context.Background())
+}
+
+// MoacBlockNumberContext is the context-aware variant of MoacBlockNumber.
+func (rpc *MoacRPC) MoacBlockNumberContext(ctx context.Context) (int, error) {
 	var response string
-	if err := rpc.call("mc_blockNumber", &response); err != nil {
+	if err := rpc.callContext(ctx, "mc_blockNumber", &response); err != nil {
 		return 0, err
 	}
 
@@ -245,8 +296,13 @@ func (rpc *MoacRPC) MoacBlockNumber() (int, error) {
 
 // MoacGetBalance returns the balance of the account of given address in wei.
 func (rpc *MoacRPC) MoacGetBalance(address, block string) (big.Int, error) {
+	return rpc.MoacGetBalanceContext(context.Background(), address, block)
+}
+
+// MoacGetBalanceContext is the context-aware variant of MoacGetBalance.
+func (rpc *MoacRPC) MoacGetBalanceContext(ctx context.Context, address, block string) (big.Int, error) {
 	var response string
-	if err := rpc.call("mc_getBalance", &response, address, block); err != nil {
+	if err := rpc.callContext(ctx, "mc_getBalance", &response, address, block); err != nil {
 		return big.Int{}, err
 	}
 
@@ -255,17 +311,27 @@ func (rpc *MoacRPC) MoacGetBalance(address, block string) (big.Int, error) {
 
 // MoacGetStorageAt returns the value from a storage position at a given address.
 func (rpc *MoacRPC) MoacGetStorageAt(data string, position int, tag string) (string, error) {
+	return rpc.MoacGetStorageAtContext(context.Background(), data, position, tag)
+}
+
+// MoacGetStorageAtContext is the context-aware variant of MoacGetStorageAt.
+func (rpc *MoacRPC) MoacGetStorageAtContext(ctx context.Context, data string, position int, tag string) (string, error) {
 	var result string
 
-	err := rpc.call("mc_getStorageAt", &result, data, IntToHex(position), tag)
+	err := rpc.callContext(ctx, "mc_getStorageAt", &result, data, IntToHex(position), tag)
 	return result, err
 }
 
 // MoacGetTransactionCount returns the number of transactions sent from an address.
 func (rpc *MoacRPC) MoacGetTransactionCount(address, block string) (int, error) {
+	return rpc.MoacGetTransactionCountContext(context.Background(), address, block)
+}
+
+// MoacGetTransactionCountContext is the context-aware variant of MoacGetTransactionCount.
+func (rpc *MoacRPC) MoacGetTransactionCountContext(ctx context.Context, address, block string) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_getTransactionCount", &response, address, block); err != nil {
+	if err := rpc.callContext(ctx, "mc_getTransactionCount", &response, address, block); err != nil {
 		return 0, err
 	}
 
@@ -274,9 +340,14 @@ func (rpc *MoacRPC) MoacGetTransactionCount(address, block string) (int, error)
 
 // MoacGetBlockTransactionCountByHash returns the number of transactions in a block from a block matching the given block hash.
 func (rpc *MoacRPC) MoacGetBlockTransactionCountByHash(hash string) (int, error) {
+	return rpc.MoacGetBlockTransactionCountByHashContext(context.Background(), hash)
+}
+
+// MoacGetBlockTransactionCountByHashContext is the context-aware variant of MoacGetBlockTransactionCountByHash.
+func (rpc *MoacRPC) MoacGetBlockTransactionCountByHashContext(ctx context.Context, hash string) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_getBlockTransactionCountByHash", &response, hash); err != nil {
+	if err := rpc.callContext(ctx, "mc_getBlockTransactionCountByHash", &response, hash); err != nil {
 		return 0, err
 	}
 
@@ -285,9 +356,14 @@ func (rpc *MoacRPC) MoacGetBlockTransactionCountByHash(hash string) (int, error)
 
 // MoacGetBlockTransactionCountByNumber returns the number of transactions in a block from a block matching the given block
 func (rpc *MoacRPC) MoacGetBlockTransactionCountByNumber(number int) (int, error) {
+	return rpc.MoacGetBlockTransactionCountByNumberContext(context.Background(), number)
+}
+
+// MoacGetBlockTransactionCountByNumberContext is the context-aware variant of MoacGetBlockTransactionCountByNumber.
+func (rpc *MoacRPC) MoacGetBlockTransactionCountByNumberContext(ctx context.Context, number int) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_getBlockTransactionCountByNumber", &response, IntToHex(number)); err != nil {
+	if err := rpc.callContext(ctx, "mc_getBlockTransactionCountByNumber", &response, IntToHex(number)); err != nil {
 		return 0, err
 	}
 
@@ -296,9 +372,14 @@ func (rpc *MoacRPC) MoacGetBlockTransactionCountByNumber(number int) (int, error
 
 // MoacGetUncleCountByBlockHash returns the number of uncles in a block from a block matching the given block hash.
 func (rpc *MoacRPC) MoacGetUncleCountByBlockHash(hash string) (int, error) {
+	return rpc.MoacGetUncleCountByBlockHashContext(context.Background(), hash)
+}
+
+// MoacGetUncleCountByBlockHashContext is the context-aware variant of MoacGetUncleCountByBlockHash.
+func (rpc *MoacRPC) MoacGetUncleCountByBlockHashContext(ctx context.Context, hash string) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_getUncleCountByBlockHash", &response, hash); err != nil {
+	if err := rpc.callContext(ctx, "mc_getUncleCountByBlockHash", &response, hash); err != nil {
 		return 0, err
 	}
 
@@ -307,9 +388,14 @@ func (rpc *MoacRPC) MoacGetUncleCountByBlockHash(hash string) (int, error) {
 
 // MoacGetUncleCountByBlockNumber returns the number of uncles in a block from a block matching the given block number.
 func (rpc *MoacRPC) MoacGetUncleCountByBlockNumber(number int) (int, error) {
+	return rpc.MoacGetUncleCountByBlockNumberContext(context.Background(), number)
+}
+
+// MoacGetUncleCountByBlockNumberContext is the context-aware variant of MoacGetUncleCountByBlockNumber.
+func (rpc *MoacRPC) MoacGetUncleCountByBlockNumberContext(ctx context.Context, number int) (int, error) {
 	var response string
 
-	if err := rpc.call("mc_getUncleCountByBlockNumber", &response, IntToHex(number)); err != nil {
+	if err := rpc.callContext(ctx, "mc_getUncleCountByBlockNumber", &response, IntToHex(number)); err != nil {
 		return 0, err
 	}
 
@@ -318,50 +404,80 @@ func (rpc *MoacRPC) MoacGetUncleCountByBlockNumber(number int) (int, error) {
 
 // MoacGetCode returns code at a given address.
 func (rpc *MoacRPC) MoacGetCode(address, block string) (string, error) {
+	return rpc.MoacGetCodeContext(context.Background(), address, block)
+}
+
+// MoacGetCodeContext is the context-aware variant of MoacGetCode.
+func (rpc *MoacRPC) MoacGetCodeContext(ctx context.Context, address, block string) (string, error) {
 	var code string
 
-	err := rpc.call("mc_getCode", &code, address, block)
+	err := rpc.callContext(ctx, "mc_getCode", &code, address, block)
 	return code, err
 }
 
 // MoacSign signs data with a given address.
 // Calculates an Moacereum specific signature with: sign(keccak256("\x19Moacereum Signed Message:\n" + len(message) + message)))
 func (rpc *MoacRPC) MoacSign(address, data string) (string, error) {
+	return rpc.MoacSignContext(context.Background(), address, data)
+}
+
+// MoacSignContext is the context-aware variant of MoacSign.
+func (rpc *MoacRPC) MoacSignContext(ctx context.Context, address, data string) (string, error) {
 	var signature string
 
-	err := rpc.call("mc_sign", &signature, address, data)
+	err := rpc.callContext(ctx, "mc_sign", &signature, address, data)
 	return signature, err
 }
 
 // MoacSendTransaction creates new message call transaction or a contract creation, if the data field contains code.
 func (rpc *MoacRPC) MoacSendTransaction(transaction T) (string, error) {
+	return rpc.MoacSendTransactionContext(context.Background(), transaction)
+}
+
+// MoacSendTransactionContext is the context-aware variant of MoacSendTransaction.
+func (rpc *MoacRPC) MoacSendTransactionContext(ctx context.Context, transaction T) (string, error) {
 	var hash string
 
-	err := rpc.call("mc_sendTransaction", &hash, transaction)
+	err := rpc.callContext(ctx, "mc_sendTransaction", &hash, transaction)
 	return hash, err
 }
 
 // MoacSendRawTransaction creates new message call transaction or a contract creation for signed transactions.
 func (rpc *MoacRPC) MoacSendRawTransaction(data string) (string, error) {
+	return rpc.MoacSendRawTransactionContext(context.Background(), data)
+}
+
+// MoacSendRawTransactionContext is the context-aware variant of MoacSendRawTransaction.
+func (rpc *MoacRPC) MoacSendRawTransactionContext(ctx context.Context, data string) (string, error) {
 	var hash string
 
-	err := rpc.call("mc_sendRawTransaction", &hash, data)
+	err := rpc.callContext(ctx, "mc_sendRawTransaction", &hash, data)
 	return hash, err
 }
 
 // MoacCall executes a new message call immediately without creating a transaction on the block chain.
 func (rpc *MoacRPC) MoacCall(transaction T, tag string) (string, error) {
+	return rpc.MoacCallContext(context.Background(), transaction, tag)
+}
+
+// MoacCallContext is the context-aware variant of MoacCall.
+func (rpc *MoacRPC) MoacCallContext(ctx context.Context, transaction T, tag string) (string, error) {
 	var data string
 
-	err := rpc.call("mc_call", &data, transaction, tag)
+	err := rpc.callContext(ctx, "mc_call", &data, transaction, tag)
 	return data, err
 }
 
 // MoacEstimateGas makes a call or transaction, which won't be added to the blockchain and returns the used gas, which can be used for estimating the used gas.
 func (rpc *MoacRPC) MoacEstimateGas(transaction T) (int, error) {
+	return rpc.MoacEstimateGasContext(context.Background(), transaction)
+}
+
+// MoacEstimateGasContext is the context-aware variant of MoacEstimateGas.
+func (rpc *MoacRPC) MoacEstimateGasContext(ctx context.Context, transaction T) (int, error) {
 	var response string
 
-	err := rpc.call("mc_estimateGas", &response, transaction)
+	err := rpc.callContext(ctx, "mc_estimateGas", &response, transaction)
 	if err != nil {
 		return 0, err
 	}
@@ -369,7 +485,7 @@ func (rpc *MoacRPC) MoacEstimateGas(transaction T) (int, error) {
 	return ParseInt(response)
 }
 
-func (rpc *MoacRPC) getBlock(method string, withTransactions bool, params ...interface{}) (*Block, error) {
+func (rpc *MoacRPC) getBlock(ctx context.Context, method string, withTransactions bool, params ...interface{}) (*Block, error) {
 	var response proxyBlock
 	if withTransactions {
 		response = new(proxyBlockWithTransactions)
@@ -377,7 +493,7 @@ func (rpc *MoacRPC) getBlock(method string, withTransactions bool, params ...int
 		response = new(proxyBlockWithoutTransactions)
 	}
 
-	err := rpc.call(method, response, params...)
+	err := rpc.callContext(ctx, method, response, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -388,42 +504,72 @@ func (rpc *MoacRPC) getBlock(method string, withTransactions bool, params ...int
 
 // MoacGetBlockByHash returns information about a block by hash.
 func (rpc *MoacRPC) MoacGetBlockByHash(hash string, withTransactions bool) (*Block, error) {
-	return rpc.getBlock("mc_getBlockByHash", withTransactions, hash, withTransactions)
+	return rpc.MoacGetBlockByHashContext(context.Background(), hash, withTransactions)
+}
+
+// MoacGetBlockByHashContext is the context-aware variant of MoacGetBlockByHash.
+func (rpc *MoacRPC) MoacGetBlockByHashContext(ctx context.Context, hash string, withTransactions bool) (*Block, error) {
+	return rpc.getBlock(ctx, "mc_getBlockByHash", withTransactions, hash, withTransactions)
 }
 
 // MoacGetBlockByNumber returns information about a block by block number.
 func (rpc *MoacRPC) MoacGetBlockByNumber(number int, withTransactions bool) (*Block, error) {
-	return rpc.getBlock("mc_getBlockByNumber", withTransactions, IntToHex(number), withTransactions)
+	return rpc.MoacGetBlockByNumberContext(context.Background(), number, withTransactions)
+}
+
+// MoacGetBlockByNumberContext is the context-aware variant of MoacGetBlockByNumber.
+func (rpc *MoacRPC) MoacGetBlockByNumberContext(ctx context.Context, number int, withTransactions bool) (*Block, error) {
+	return rpc.getBlock(ctx, "mc_getBlockByNumber", withTransactions, IntToHex(number), withTransactions)
 }
 
-func (rpc *MoacRPC) getTransaction(method string, params ...interface{}) (*Transaction, error) {
+func (rpc *MoacRPC) getTransaction(ctx context.Context, method string, params ...interface{}) (*Transaction, error) {
 	transaction := new(Transaction)
 
-	err := rpc.call(method, transaction, params...)
+	err := rpc.callContext(ctx, method, transaction, params...)
 	return transaction, err
 }
 
 // MoacGetTransactionByHash returns the information about a transaction requested by transaction hash.
 func (rpc *MoacRPC) MoacGetTransactionByHash(hash string) (*Transaction, error) {
-	return rpc.getTransaction("mc_getTransactionByHash", hash)
+	return rpc.MoacGetTransactionByHashContext(context.Background(), hash)
+}
+
+// MoacGetTransactionByHashContext is the context-aware variant of MoacGetTransactionByHash.
+func (rpc *MoacRPC) MoacGetTransactionByHashContext(ctx context.Context, hash string) (*Transaction, error) {
+	return rpc.getTransaction(ctx, "mc_getTransactionByHash", hash)
 }
 
 // MoacGetTransactionByBlockHashAndIndex returns information about a transaction by block hash and transaction index position.
 func (rpc *MoacRPC) MoacGetTransactionByBlockHashAndIndex(blockHash string, transactionIndex int) (*Transaction, error) {
-	return rpc.getTransaction("mc_getTransactionByBlockHashAndIndex", blockHash, IntToHex(transactionIndex))
+	return rpc.MoacGetTransactionByBlockHashAndIndexContext(context.Background(), blockHash, transactionIndex)
+}
+
+// MoacGetTransactionByBlockHashAndIndexContext is the context-aware variant of MoacGetTransactionByBlockHashAndIndex.
+func (rpc *MoacRPC) MoacGetTransactionByBlockHashAndIndexContext(ctx context.Context, blockHash string, transactionIndex int) (*Transaction, error) {
+	return rpc.getTransaction(ctx, "mc_getTransactionByBlockHashAndIndex", blockHash, IntToHex(transactionIndex))
 }
 
 // MoacGetTransactionByBlockNumberAndIndex returns information about a transaction by block number and transaction index position.
 func (rpc *MoacRPC) MoacGetTransactionByBlockNumberAndIndex(blockNumber, transactionIndex int) (*Transaction, error) {
-	return rpc.getTransaction("mc_getTransactionByBlockNumberAndIndex", IntToHex(blockNumber), IntToHex(transactionIndex))
+	return rpc.MoacGetTransactionByBlockNumberAndIndexContext(context.Background(), blockNumber, transactionIndex)
+}
+
+// MoacGetTransactionByBlockNumberAndIndexContext is the context-aware variant of MoacGetTransactionByBlockNumberAndIndex.
+func (rpc *MoacRPC) MoacGetTransactionByBlockNumberAndIndexContext(ctx context.Context, blockNumber, transactionIndex int) (*Transaction, error) {
+	return rpc.getTransaction(ctx, "mc_getTransactionByBlockNumberAndIndex", IntToHex(blockNumber), IntToHex(transactionIndex))
 }
 
 // MoacGetTransactionReceipt returns the receipt of a transaction by transaction hash.
 // Note That the receipt is not available for pending transactions.
 func (rpc *MoacRPC) MoacGetTransactionReceipt(hash string) (*TransactionReceipt, error) {
+	return rpc.MoacGetTransactionReceiptContext(context.Background(), hash)
+}
+
+// MoacGetTransactionReceiptContext is the context-aware variant of MoacGetTransactionReceipt.
+func (rpc *MoacRPC) MoacGetTransactionReceiptContext(ctx context.Context, hash string) (*TransactionReceipt, error) {
 	transactionReceipt := new(TransactionReceipt)
 
-	err := rpc.call("mc_getTransactionReceipt", transactionReceipt, hash)
+	err := rpc.callContext(ctx, "mc_getTransactionReceipt", transactionReceipt, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -433,60 +579,100 @@ func (rpc *MoacRPC) MoacGetTransactionReceipt(hash string) (*TransactionReceipt,
 
 // MoacGetCompilers returns a list of available compilers in the client.
 func (rpc *MoacRPC) MoacGetCompilers() ([]string, error) {
+	return rpc.MoacGetCompilersContext(context.Background())
+}
+
+// MoacGetCompilersContext is the context-aware variant of MoacGetCompilers.
+func (rpc *MoacRPC) MoacGetCompilersContext(ctx context.Context) ([]string, error) {
 	compilers := []string{}
 
-	err := rpc.call("mc_getCompilers", &compilers)
+	err := rpc.callContext(ctx, "mc_getCompilers", &compilers)
 	return compilers, err
 }
 
 // MoacNewFilter creates a new filter object.
 func (rpc *MoacRPC) MoacNewFilter(params FilterParams) (string, error) {
+	return rpc.MoacNewFilterContext(context.Background(), params)
+}
+
+// MoacNewFilterContext is the context-aware variant of MoacNewFilter.
+func (rpc *MoacRPC) MoacNewFilterContext(ctx context.Context, params FilterParams) (string, error) {
 	var filterID string
-	err := rpc.call("mc_newFilter", &filterID, params)
+	err := rpc.callContext(ctx, "mc_newFilter", &filterID, params)
 	return filterID, err
 }
 
 // MoacNewBlockFilter creates a filter in the node, to notify when a new block arrives.
 // To check if the state has changed, call MoacGetFilterChanges.
 func (rpc *MoacRPC) MoacNewBlockFilter() (string, error) {
+	return rpc.MoacNewBlockFilterContext(context.Background())
+}
+
+// MoacNewBlockFilterContext is the context-aware variant of MoacNewBlockFilter.
+func (rpc *MoacRPC) MoacNewBlockFilterContext(ctx context.Context) (string, error) {
 	var filterID string
-	err := rpc.call("mc_newBlockFilter", &filterID)
+	err := rpc.callContext(ctx, "mc_newBlockFilter", &filterID)
 	return filterID, err
 }
 
 // MoacNewPendingTransactionFilter creates a filter in the node, to notify when new pending transactions arrive.
 // To check if the state has changed, call MoacGetFilterChanges.
 func (rpc *MoacRPC) MoacNewPendingTransactionFilter() (string, error) {
+	return rpc.MoacNewPendingTransactionFilterContext(context.Background())
+}
+
+// MoacNewPendingTransactionFilterContext is the context-aware variant of MoacNewPendingTransactionFilter.
+func (rpc *MoacRPC) MoacNewPendingTransactionFilterContext(ctx context.Context) (string, error) {
 	var filterID string
-	err := rpc.call("mc_newPendingTransactionFilter", &filterID)
+	err := rpc.callContext(ctx, "mc_newPendingTransactionFilter", &filterID)
 	return filterID, err
 }
 
 // MoacUninstallFilter uninstalls a filter with given id.
 func (rpc *MoacRPC) MoacUninstallFilter(filterID string) (bool, error) {
+	return rpc.MoacUninstallFilterContext(context.Background(), filterID)
+}
+
+// MoacUninstallFilterContext is the context-aware variant of MoacUninstallFilter.
+func (rpc *MoacRPC) MoacUninstallFilterContext(ctx context.Context, filterID string) (bool, error) {
 	var res bool
-	err := rpc.call("mc_uninstallFilter", &res, filterID)
+	err := rpc.callContext(ctx, "mc_uninstallFilter", &res, filterID)
 	return res, err
 }
 
 // MoacGetFilterChanges polling method for a filter, which returns an array of logs which occurred since last poll.
 func (rpc *MoacRPC) MoacGetFilterChanges(filterID string) ([]Log, error) {
+	return rpc.MoacGetFilterChangesContext(context.Background(), filterID)
+}
+
+// MoacGetFilterChangesContext is the context-aware variant of MoacGetFilterChanges.
+func (rpc *MoacRPC) MoacGetFilterChangesContext(ctx context.Context, filterID string) ([]Log, error) {
 	var logs = []Log{}
-	err := rpc.call("mc_getFilterChanges", &logs, filterID)
+	err := rpc.callContext(ctx, "mc_getFilterChanges", &logs, filterID)
 	return logs, err
 }
 
 // MoacGetFilterLogs returns an array of all logs matching filter with given id.
 func (rpc *MoacRPC) MoacGetFilterLogs(filterID string) ([]Log, error) {
+	return rpc.MoacGetFilterLogsContext(context.Background(), filterID)
+}
+
+// MoacGetFilterLogsContext is the context-aware variant of MoacGetFilterLogs.
+func (rpc *MoacRPC) MoacGetFilterLogsContext(ctx context.Context, filterID string) ([]Log, error) {
 	var logs = []Log{}
-	err := rpc.call("mc_getFilterLogs", &logs, filterID)
+	err := rpc.callContext(ctx, "mc_getFilterLogs", &logs, filterID)
 	return logs, err
 }
 
 // MoacGetLogs returns an array of all logs matching a given filter object.
 func (rpc *MoacRPC) MoacGetLogs(params FilterParams) ([]Log, error) {
+	return rpc.MoacGetLogsContext(context.Background(), params)
+}
+
+// MoacGetLogsContext is the context-aware variant of MoacGetLogs.
+func (rpc *MoacRPC) MoacGetLogsContext(ctx context.Context, params FilterParams) ([]Log, error) {
 	var logs = []Log{}
-	err := rpc.call("mc_getLogs", &logs, params)
+	err := rpc.callContext(ctx, "mc_getLogs", &logs, params)
 	return logs, err
 }
 
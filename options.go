@@ -1,22 +1,37 @@
 package moacrpc
 
 import (
-	"io"
 	"net/http"
+
+	"golang.org/x/time/rate"
 )
 
 type httpClient interface {
-	Post(url string, contentType string, body io.Reader) (*http.Response, error)
+	Do(req *http.Request) (*http.Response, error)
 }
 
 type logger interface {
 	Println(v ...interface{})
 }
 
-// WithHttpClient set custom http client
+// WithHttpClient set custom http client. It only takes effect for http(s)
+// endpoints; use WithTransport to customize a ws:// or unix socket client.
 func WithHttpClient(client httpClient) func(rpc *MoacRPC) {
 	return func(rpc *MoacRPC) {
-		rpc.client = client
+		ht, ok := rpc.transport.(*HTTPTransport)
+		if !ok {
+			return
+		}
+		ht.client = client
+	}
+}
+
+// WithTransport overrides the Transport used to reach the node, e.g. to
+// force a WebSocketTransport or IPCTransport regardless of the url scheme,
+// or to inject a test double.
+func WithTransport(transport Transport) func(rpc *MoacRPC) {
+	return func(rpc *MoacRPC) {
+		rpc.transport = transport
 	}
 }
 
@@ -33,3 +48,50 @@ func WithDebug(enabled bool) func(rpc *MoacRPC) {
 		rpc.Debug = enabled
 	}
 }
+
+// WithTxModifiers registers TxModifiers applied, in order, by SendTransaction
+// before a transaction is signed.
+func WithTxModifiers(modifiers ...TxModifier) func(rpc *MoacRPC) {
+	return func(rpc *MoacRPC) {
+		rpc.txModifiers = append(rpc.txModifiers, modifiers...)
+	}
+}
+
+// WithEndpoints points an http(s) client at multiple nodes for HA, wrapping
+// its http client in a FailoverClient that spreads requests across them and
+// retries transient failures per the client's RetryPolicy (see
+// WithRetryPolicy). It only takes effect for http(s) endpoints.
+func WithEndpoints(endpoints []string) func(rpc *MoacRPC) {
+	return func(rpc *MoacRPC) {
+		ht, ok := rpc.transport.(*HTTPTransport)
+		if !ok {
+			return
+		}
+		rpc.failover = NewFailoverClient(endpoints, ht.client, rpc.retryPolicy)
+		ht.client = rpc.failover
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy used by a FailoverClient set up via
+// WithEndpoints. Apply it before or after WithEndpoints; either order works.
+func WithRetryPolicy(policy RetryPolicy) func(rpc *MoacRPC) {
+	return func(rpc *MoacRPC) {
+		rpc.retryPolicy = policy
+		if rpc.failover != nil {
+			rpc.failover.policy = policy
+		}
+	}
+}
+
+// WithRateLimit throttles outbound RPCs to at most rps per second. Useful
+// against nodes that enforce a per-key QPS and would otherwise be hammered
+// blindly. It only takes effect for http(s) endpoints.
+func WithRateLimit(rps int) func(rpc *MoacRPC) {
+	return func(rpc *MoacRPC) {
+		ht, ok := rpc.transport.(*HTTPTransport)
+		if !ok {
+			return
+		}
+		ht.client = &rateLimitedClient{client: ht.client, limiter: rate.NewLimiter(rate.Limit(rps), rps)}
+	}
+}
@@ -0,0 +1,236 @@
+package moacrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// wireConn is the minimal duplex message interface a pub-sub capable
+// transport needs; WebSocketTransport and IPCTransport each supply one.
+type wireConn interface {
+	ReadMessage() ([]byte, error)
+	WriteMessage(data []byte) error
+	Close() error
+}
+
+// subscriptionNotification mirrors the envelope moac nodes wrap
+// mc_subscription notifications in.
+type subscriptionNotification struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+type subscriptionMessage struct {
+	Method string                   `json:"method"`
+	Params subscriptionNotification `json:"params"`
+}
+
+// pubsubTransport multiplexes JSON-RPC requests and mc_subscription
+// notifications over a single duplex connection: responses are dispatched
+// back to the Call that issued the matching request id, and notifications
+// are routed to the Subscription with the matching subscription id. Both
+// WebSocketTransport and IPCTransport embed one of these over their own
+// wireConn.
+type pubsubTransport struct {
+	conn wireConn
+
+	// writeMu serializes WriteMessage calls: gorilla/websocket.Conn forbids
+	// concurrent writers, and a plain net.Conn offers no atomicity guarantee
+	// either, so every Call (including the ones BatchCall's fallback fires
+	// off concurrently) must take this before writing a frame.
+	writeMu sync.Mutex
+
+	mu            sync.Mutex
+	nextID        int
+	pending       map[int]chan ethResponse
+	subscriptions map[string]chan<- json.RawMessage
+	closed        chan struct{}
+}
+
+func newPubsubTransport(conn wireConn) *pubsubTransport {
+	t := &pubsubTransport{
+		conn:          conn,
+		pending:       make(map[int]chan ethResponse),
+		subscriptions: make(map[string]chan<- json.RawMessage),
+		closed:        make(chan struct{}),
+	}
+	go t.readLoop()
+	return t
+}
+
+func (t *pubsubTransport) readLoop() {
+	for {
+		data, err := t.conn.ReadMessage()
+		if err != nil {
+			t.shutdown()
+			return
+		}
+
+		var peek struct {
+			ID     *int   `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.Unmarshal(data, &peek); err != nil {
+			continue
+		}
+
+		if peek.ID != nil {
+			t.mu.Lock()
+			ch, ok := t.pending[*peek.ID]
+			if ok {
+				delete(t.pending, *peek.ID)
+			}
+			t.mu.Unlock()
+			if !ok {
+				continue
+			}
+
+			var resp ethResponse
+			if err := json.Unmarshal(data, &resp); err != nil {
+				continue
+			}
+			ch <- resp
+			continue
+		}
+
+		if peek.Method == "mc_subscription" {
+			var msg subscriptionMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				continue
+			}
+
+			// The send is non-blocking (select with default drops the
+			// notification instead of waiting on it), so it's safe to
+			// hold mu across it: it can never block the connection, and
+			// holding mu still prevents a concurrent unsubscribe/shutdown
+			// from closing the channel out from under us. A blocking send
+			// here would otherwise wedge every other Call, Subscribe and
+			// Unsubscribe on this transport behind one slow subscriber.
+			t.mu.Lock()
+			if ch, ok := t.subscriptions[msg.Params.Subscription]; ok {
+				select {
+				case ch <- msg.Params.Result:
+				default:
+				}
+			}
+			t.mu.Unlock()
+		}
+	}
+}
+
+// shutdown runs when the underlying connection dies (conn.ReadMessage
+// errored). It unblocks every in-flight Call and closes every subscription
+// channel, so a consumer ranging over a subscription channel observes the
+// outage instead of blocking forever.
+func (t *pubsubTransport) shutdown() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	select {
+	case <-t.closed:
+		return
+	default:
+	}
+	close(t.closed)
+
+	for id, ch := range t.pending {
+		close(ch)
+		delete(t.pending, id)
+	}
+	for subID, ch := range t.subscriptions {
+		close(ch)
+		delete(t.subscriptions, subID)
+	}
+}
+
+// Call implements Transport.
+func (t *pubsubTransport) Call(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error) {
+	t.mu.Lock()
+	t.nextID++
+	id := t.nextID
+	respCh := make(chan ethResponse, 1)
+	t.pending[id] = respCh
+	t.mu.Unlock()
+
+	body, err := json.Marshal(ethRequest{ID: id, JSONRPC: "2.0", Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+
+	t.writeMu.Lock()
+	err = t.conn.WriteMessage(body)
+	t.writeMu.Unlock()
+	if err != nil {
+		t.mu.Lock()
+		delete(t.pending, id)
+		t.mu.Unlock()
+		return nil, err
+	}
+
+	select {
+	case resp, ok := <-respCh:
+		if !ok {
+			return nil, fmt.Errorf("moacrpc: transport closed before response for %q", method)
+		}
+		if resp.Error != nil {
+			return nil, *resp.Error
+		}
+		return resp.Result, nil
+	case <-ctx.Done():
+		t.mu.Lock()
+		delete(t.pending, id)
+		t.mu.Unlock()
+		return nil, ctx.Err()
+	case <-t.closed:
+		return nil, fmt.Errorf("moacrpc: transport closed")
+	}
+}
+
+// Subscribe implements Transport.
+func (t *pubsubTransport) Subscribe(ctx context.Context, channel chan<- json.RawMessage, channelType string, params ...interface{}) (*Subscription, error) {
+	callParams := append([]interface{}{channelType}, params...)
+
+	result, err := t.Call(ctx, "mc_subscribe", callParams...)
+	if err != nil {
+		return nil, err
+	}
+
+	var subID string
+	if err := json.Unmarshal(result, &subID); err != nil {
+		return nil, fmt.Errorf("moacrpc: unexpected mc_subscribe result: %w", err)
+	}
+
+	t.mu.Lock()
+	t.subscriptions[subID] = channel
+	t.mu.Unlock()
+
+	return &Subscription{id: subID, transport: t}, nil
+}
+
+// unsubscribe removes subID from subscriptions and closes its channel,
+// then tells the node to stop sending notifications for it. The map
+// delete-and-check happens under t.mu, which shutdown() also takes before
+// closing a subscription's channel, so exactly one of unsubscribe/shutdown
+// ever closes a given channel even if they race.
+func (t *pubsubTransport) unsubscribe(ctx context.Context, subID string) error {
+	t.mu.Lock()
+	ch, ok := t.subscriptions[subID]
+	if ok {
+		delete(t.subscriptions, subID)
+	}
+	t.mu.Unlock()
+
+	if ok {
+		close(ch)
+	}
+
+	_, err := t.Call(ctx, "mc_unsubscribe", subID)
+	return err
+}
+
+// Close implements Transport.
+func (t *pubsubTransport) Close() error {
+	return t.conn.Close()
+}
@@ -0,0 +1,23 @@
+package moacrpc
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedClient throttles outbound requests to at most the configured
+// rate using a token-bucket limiter, so the client doesn't hammer endpoints
+// (Infura-style gateways, public MOAC nodes) that enforce a per-key QPS.
+type rateLimitedClient struct {
+	client  httpClient
+	limiter *rate.Limiter
+}
+
+// Do implements httpClient.
+func (c *rateLimitedClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
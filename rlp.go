@@ -0,0 +1,66 @@
+package moacrpc
+
+import (
+	"errors"
+	"math/big"
+)
+
+// rlpEncode implements the subset of Ethereum's Recursive Length Prefix
+// encoding KeystoreSigner needs: a flat list of []byte, uint64 and *big.Int
+// items RLP-encoded as a single list, which is exactly what a legacy
+// transaction's wire and signing-hash encodings are.
+func rlpEncode(items ...interface{}) ([]byte, error) {
+	var payload []byte
+	for _, item := range items {
+		encoded, err := rlpEncodeItem(item)
+		if err != nil {
+			return nil, err
+		}
+		payload = append(payload, encoded...)
+	}
+
+	return append(rlpEncodeLength(len(payload), 0xc0), payload...), nil
+}
+
+func rlpEncodeItem(item interface{}) ([]byte, error) {
+	switch v := item.(type) {
+	case []byte:
+		return rlpEncodeBytes(v), nil
+	case uint64:
+		return rlpEncodeBytes(trimmedBigEndian(new(big.Int).SetUint64(v))), nil
+	case *big.Int:
+		if v == nil {
+			return rlpEncodeBytes(nil), nil
+		}
+		return rlpEncodeBytes(trimmedBigEndian(v)), nil
+	default:
+		return nil, errors.New("moacrpc: rlp: unsupported item type")
+	}
+}
+
+// trimmedBigEndian returns v's minimal big-endian representation, which is
+// nil (the empty string) for zero, matching RLP's canonical integer encoding.
+func trimmedBigEndian(v *big.Int) []byte {
+	if v.Sign() == 0 {
+		return nil
+	}
+	return v.Bytes()
+}
+
+func rlpEncodeBytes(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return data
+	}
+	return append(rlpEncodeLength(len(data), 0x80), data...)
+}
+
+// rlpEncodeLength returns the length prefix for a string (offset 0x80) or
+// list (offset 0xc0) payload of the given length.
+func rlpEncodeLength(length int, offset byte) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+
+	lengthBytes := trimmedBigEndian(new(big.Int).SetInt64(int64(length)))
+	return append([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
@@ -0,0 +1,49 @@
+package moacrpc
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+)
+
+// Signer produces a signed, RLP-encoded transaction ready to be submitted
+// via mc_sendRawTransaction.
+type Signer interface {
+	Sign(ctx context.Context, tx *T) ([]byte, error)
+}
+
+// chainIDSetter is implemented by signers (e.g. KeystoreSigner) whose
+// EIP-155 chain id can be set after construction. SendTransaction calls it
+// when a ChainIDProvider is configured via WithTxModifiers, so the chain id
+// resolved there reaches the signer without the caller re-threading it by hand.
+type chainIDSetter interface {
+	SetChainID(chainID *big.Int)
+}
+
+// SendTransaction applies the client's TxModifiers to tx (see
+// WithTxModifiers), signs the result with signer, and submits it via
+// mc_sendRawTransaction, so keys never have to leave the caller's process.
+func (rpc *MoacRPC) SendTransaction(ctx context.Context, tx *T, signer Signer) (string, error) {
+	for _, modifier := range rpc.txModifiers {
+		if err := modifier.Modify(ctx, rpc, tx); err != nil {
+			return "", err
+		}
+
+		if provider, ok := modifier.(*ChainIDProvider); ok {
+			if setter, ok := signer.(chainIDSetter); ok {
+				chainID, err := provider.ChainID(ctx, rpc)
+				if err != nil {
+					return "", err
+				}
+				setter.SetChainID(chainID)
+			}
+		}
+	}
+
+	raw, err := signer.Sign(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	return rpc.MoacSendRawTransactionContext(ctx, "0x"+hex.EncodeToString(raw))
+}
@@ -0,0 +1,51 @@
+package moacrpc
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Subscription represents an active mc_subscribe stream. Notifications are
+// delivered on the channel passed to Subscribe until Unsubscribe is called
+// or the underlying transport is closed, either of which closes the channel
+// exactly once. Delivery is non-blocking: a notification is dropped rather
+// than delivered if the channel isn't ready to receive it, so callers that
+// can't afford to miss one should pass a buffered channel and drain it promptly.
+type Subscription struct {
+	id        string
+	transport *pubsubTransport
+}
+
+// ID returns the node-assigned subscription id carried by mc_subscription notifications.
+func (s *Subscription) ID() string {
+	return s.id
+}
+
+// Unsubscribe issues mc_unsubscribe, stops routing notifications to this
+// subscription, and closes its channel.
+func (s *Subscription) Unsubscribe(ctx context.Context) error {
+	return s.transport.unsubscribe(ctx, s.id)
+}
+
+// Subscribe opens a subscription of the given channelType (e.g. "newHeads",
+// "logs", "newPendingTransactions") and routes every notification it
+// receives onto channel. It returns ErrSubscriptionsNotSupported unless the
+// client was constructed against a ws://, wss://, or unix socket endpoint.
+func (rpc *MoacRPC) Subscribe(ctx context.Context, channel chan<- json.RawMessage, channelType string, params ...interface{}) (*Subscription, error) {
+	return rpc.transport.Subscribe(ctx, channel, channelType, params...)
+}
+
+// SubscribeNewHeads streams newly mined block headers to channel.
+func (rpc *MoacRPC) SubscribeNewHeads(ctx context.Context, channel chan<- json.RawMessage) (*Subscription, error) {
+	return rpc.Subscribe(ctx, channel, "newHeads")
+}
+
+// SubscribeLogs streams logs matching params to channel as they are mined.
+func (rpc *MoacRPC) SubscribeLogs(ctx context.Context, channel chan<- json.RawMessage, params FilterParams) (*Subscription, error) {
+	return rpc.Subscribe(ctx, channel, "logs", params)
+}
+
+// SubscribePendingTransactions streams the hashes of newly seen pending transactions to channel.
+func (rpc *MoacRPC) SubscribePendingTransactions(ctx context.Context, channel chan<- json.RawMessage) (*Subscription, error) {
+	return rpc.Subscribe(ctx, channel, "newPendingTransactions")
+}
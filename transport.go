@@ -0,0 +1,217 @@
+package moacrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrSubscriptionsNotSupported is returned by Transport implementations that
+// only support request/response calls, such as HTTPTransport.
+var ErrSubscriptionsNotSupported = errors.New("moacrpc: transport does not support subscriptions, use a ws://, wss:// or unix socket endpoint")
+
+// Transport is the wire-level abstraction MoacRPC calls through to reach a
+// node. New picks an implementation from the scheme of the url passed to
+// it, and WithTransport lets callers override that choice.
+type Transport interface {
+	// Call performs a single JSON-RPC request and returns its raw result.
+	Call(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error)
+	// Subscribe issues an mc_subscribe call for channelType (e.g. "newHeads",
+	// "logs", "newPendingTransactions") and routes every mc_subscription
+	// notification for the returned subscription id onto channel.
+	Subscribe(ctx context.Context, channel chan<- json.RawMessage, channelType string, params ...interface{}) (*Subscription, error)
+	// Close releases any resources (sockets, goroutines) held by the transport.
+	Close() error
+}
+
+// HTTPTransport is the default Transport: one JSON-RPC request per HTTP
+// POST. It does not support Subscribe; pending notifications must be
+// polled with MoacNewBlockFilter/MoacGetFilterChanges instead.
+type HTTPTransport struct {
+	url    string
+	client httpClient
+}
+
+// NewHTTPTransport creates an HTTPTransport posting JSON-RPC requests to url
+// via client. If client is nil, http.DefaultClient is used.
+func NewHTTPTransport(url string, client httpClient) *HTTPTransport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPTransport{url: url, client: client}
+}
+
+// Call implements Transport.
+func (t *HTTPTransport) Call(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error) {
+	request := ethRequest{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := t.client.Do(req)
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ethResponse)
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Error != nil {
+		return nil, *resp.Error
+	}
+
+	return resp.Result, nil
+}
+
+// Subscribe always fails for HTTPTransport; see ErrSubscriptionsNotSupported.
+func (t *HTTPTransport) Subscribe(ctx context.Context, channel chan<- json.RawMessage, channelType string, params ...interface{}) (*Subscription, error) {
+	return nil, ErrSubscriptionsNotSupported
+}
+
+// BatchCall sends calls as a single JSON-RPC 2.0 batch request (a JSON
+// array of requests), assigning each an incrementing id, and scatters the
+// response array back into calls by id.
+func (t *HTTPTransport) BatchCall(ctx context.Context, calls []BatchElem) error {
+	requests := make([]ethRequest, len(calls))
+	for i, call := range calls {
+		requests[i] = ethRequest{ID: i + 1, JSONRPC: "2.0", Method: call.Method, Params: call.Params}
+	}
+
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := t.client.Do(req)
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	var responses []ethResponse
+	if err := json.Unmarshal(data, &responses); err != nil {
+		return err
+	}
+
+	byID := make(map[int]*ethResponse, len(responses))
+	for i := range responses {
+		byID[responses[i].ID] = &responses[i]
+	}
+
+	for i := range calls {
+		resp, ok := byID[i+1]
+		if !ok {
+			calls[i].Error = fmt.Errorf("moacrpc: no response for batch call %d (%s)", i+1, calls[i].Method)
+			continue
+		}
+		if resp.Error != nil {
+			calls[i].Error = *resp.Error
+			continue
+		}
+		if calls[i].Result != nil {
+			calls[i].Error = json.Unmarshal(resp.Result, calls[i].Result)
+		}
+	}
+
+	return nil
+}
+
+// Close is a no-op for HTTPTransport, which holds no persistent connection.
+func (t *HTTPTransport) Close() error {
+	return nil
+}
+
+// defaultTransport picks a Transport for url based on its scheme. Dialing
+// is deferred to the first Call/Subscribe (see lazyTransport) so that New
+// never has to return an error for an unreachable ws:// or IPC endpoint.
+func defaultTransport(url string) Transport {
+	switch {
+	case strings.HasPrefix(url, "ws://"), strings.HasPrefix(url, "wss://"):
+		return &lazyTransport{dial: func() (Transport, error) { return NewWebSocketTransport(url) }}
+	case strings.HasPrefix(url, "http://"), strings.HasPrefix(url, "https://"):
+		return NewHTTPTransport(url, http.DefaultClient)
+	default:
+		return &lazyTransport{dial: func() (Transport, error) { return NewIPCTransport(url) }}
+	}
+}
+
+// lazyTransport defers dialing a Transport until first use.
+type lazyTransport struct {
+	dial func() (Transport, error)
+
+	once      sync.Once
+	transport Transport
+	err       error
+}
+
+func (t *lazyTransport) resolve() (Transport, error) {
+	t.once.Do(func() {
+		t.transport, t.err = t.dial()
+	})
+	return t.transport, t.err
+}
+
+func (t *lazyTransport) Call(ctx context.Context, method string, params ...interface{}) (json.RawMessage, error) {
+	transport, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return transport.Call(ctx, method, params...)
+}
+
+func (t *lazyTransport) Subscribe(ctx context.Context, channel chan<- json.RawMessage, channelType string, params ...interface{}) (*Subscription, error) {
+	transport, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return transport.Subscribe(ctx, channel, channelType, params...)
+}
+
+func (t *lazyTransport) Close() error {
+	if t.transport == nil {
+		return nil
+	}
+	return t.transport.Close()
+}
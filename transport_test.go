@@ -0,0 +1,64 @@
+package moacrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHTTPTransportBatchCallScattersByID drives BatchCall against a server
+// that returns responses out of order and omits one id entirely, to make
+// sure results land back on the right BatchElem rather than by position.
+func TestHTTPTransportBatchCallScattersByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []ethRequest
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Fatalf("decoding batch request: %v", err)
+		}
+		if len(requests) != 3 {
+			t.Fatalf("got %d requests, want 3", len(requests))
+		}
+
+		// Reply out of order and skip id 2 (simulating a node that errors
+		// out before producing a response for one call).
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":3,"jsonrpc":"2.0","result":"0x3"},
+			{"id":1,"jsonrpc":"2.0","result":"0x1"}
+		]`))
+	}))
+	defer server.Close()
+
+	transport := NewHTTPTransport(server.URL, nil)
+
+	var first, third string
+	calls := []BatchElem{
+		{Method: "mc_getBalance", Params: []interface{}{"0xa"}, Result: &first},
+		{Method: "mc_getBalance", Params: []interface{}{"0xb"}},
+		{Method: "mc_getBalance", Params: []interface{}{"0xc"}, Result: &third},
+	}
+
+	if err := transport.BatchCall(context.Background(), calls); err != nil {
+		t.Fatalf("BatchCall: unexpected error: %v", err)
+	}
+
+	if calls[0].Error != nil {
+		t.Errorf("calls[0].Error = %v, want nil", calls[0].Error)
+	}
+	if first != "0x1" {
+		t.Errorf("calls[0].Result = %q, want %q", first, "0x1")
+	}
+
+	if calls[1].Error == nil {
+		t.Error("calls[1].Error = nil, want an error for the missing response")
+	}
+
+	if calls[2].Error != nil {
+		t.Errorf("calls[2].Error = %v, want nil", calls[2].Error)
+	}
+	if third != "0x3" {
+		t.Errorf("calls[2].Result = %q, want %q", third, "0x3")
+	}
+}
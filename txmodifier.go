@@ -0,0 +1,136 @@
+package moacrpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// TxModifier mutates tx in place before it is signed and sent, typically to
+// fill in a field the caller left at its zero value (nonce, gas, gas
+// price). Modifiers passed to WithTxModifiers run in order inside
+// SendTransaction.
+type TxModifier interface {
+	Modify(ctx context.Context, rpc *MoacRPC, tx *T) error
+}
+
+// NonceProvider fills tx.Nonce from MoacGetTransactionCount against the
+// "pending" block if it is unset.
+type NonceProvider struct{}
+
+// Modify implements TxModifier.
+func (NonceProvider) Modify(ctx context.Context, rpc *MoacRPC, tx *T) error {
+	if tx.Nonce != 0 {
+		return nil
+	}
+
+	nonce, err := rpc.MoacGetTransactionCountContext(ctx, tx.From, "pending")
+	if err != nil {
+		return err
+	}
+
+	tx.Nonce = nonce
+	return nil
+}
+
+// GasLimitEstimator fills tx.Gas from MoacEstimateGas if it is unset,
+// scaled by Multiplier to leave headroom for estimation error. A
+// Multiplier of 0 is treated as 1 (no headroom).
+type GasLimitEstimator struct {
+	Multiplier float64
+}
+
+// Modify implements TxModifier.
+func (e GasLimitEstimator) Modify(ctx context.Context, rpc *MoacRPC, tx *T) error {
+	if tx.Gas != 0 {
+		return nil
+	}
+
+	gas, err := rpc.MoacEstimateGasContext(ctx, *tx)
+	if err != nil {
+		return err
+	}
+
+	multiplier := e.Multiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+
+	tx.Gas = int(float64(gas) * multiplier)
+	return nil
+}
+
+// GasPriceProvider fills tx.GasPrice from MoacGasPrice if it is unset,
+// scaled by Multiplier (0 is treated as 1) and capped at Max. A nil Max
+// disables the cap.
+type GasPriceProvider struct {
+	Multiplier float64
+	Max        *big.Int
+}
+
+// Modify implements TxModifier.
+func (p GasPriceProvider) Modify(ctx context.Context, rpc *MoacRPC, tx *T) error {
+	if tx.GasPrice != nil {
+		return nil
+	}
+
+	price, err := rpc.MoacGasPriceContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	multiplier := p.Multiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+
+	scaled, _ := new(big.Float).Mul(new(big.Float).SetInt(&price), big.NewFloat(multiplier)).Int(nil)
+	if p.Max != nil && scaled.Cmp(p.Max) > 0 {
+		scaled = p.Max
+	}
+
+	tx.GasPrice = scaled
+	return nil
+}
+
+// ChainIDProvider resolves the chain id used for EIP-155 signing, querying
+// net_version once and caching the result. Set Fixed to skip the RPC round
+// trip entirely for networks with a known, stable chain id.
+type ChainIDProvider struct {
+	Fixed *big.Int
+
+	cached *big.Int
+}
+
+// ChainID returns the chain id, querying net_version and caching the result
+// unless Fixed was set.
+func (p *ChainIDProvider) ChainID(ctx context.Context, rpc *MoacRPC) (*big.Int, error) {
+	if p.Fixed != nil {
+		return p.Fixed, nil
+	}
+	if p.cached != nil {
+		return p.cached, nil
+	}
+
+	version, err := rpc.NetVersionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok := new(big.Int).SetString(version, 10)
+	if !ok {
+		return nil, fmt.Errorf("moacrpc: unexpected net_version %q", version)
+	}
+
+	p.cached = id
+	return id, nil
+}
+
+// Modify implements TxModifier as a no-op on tx itself: ChainIDProvider
+// doesn't touch the transaction, it feeds a Signer's chain id.
+// SendTransaction detects a configured ChainIDProvider and calls its
+// ChainID method to push the resolved id into any Signer implementing
+// SetChainID, which is where this provider actually takes effect.
+func (p *ChainIDProvider) Modify(ctx context.Context, rpc *MoacRPC, tx *T) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+package moacrpc
+
+import (
+	"github.com/gorilla/websocket"
+)
+
+// wsConn adapts a gorilla websocket.Conn to the wireConn interface expected by pubsubTransport.
+type wsConn struct {
+	conn *websocket.Conn
+}
+
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	_, data, err := c.conn.ReadMessage()
+	return data, err
+}
+
+func (c *wsConn) WriteMessage(data []byte) error {
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
+
+// WebSocketTransport is a Transport backed by a persistent WebSocket
+// connection, supporting both request/response calls and mc_subscribe
+// pub-sub notifications multiplexed by request/subscription id.
+type WebSocketTransport struct {
+	*pubsubTransport
+}
+
+// NewWebSocketTransport dials url (ws:// or wss://) and returns a
+// WebSocketTransport ready to Call and Subscribe.
+func NewWebSocketTransport(url string) (*WebSocketTransport, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebSocketTransport{pubsubTransport: newPubsubTransport(&wsConn{conn: conn})}, nil
+}